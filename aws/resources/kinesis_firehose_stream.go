@@ -0,0 +1,139 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/internal/concurrency"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+func (kfs *KinesisFirehoseStreams) getAll(c context.Context, configObj config.Config) ([]*string, error) {
+	var allDeliveryStreams []*string
+
+	// Unlike Kinesis Data Streams, the Firehose ListDeliveryStreams API is not paginator-based: it returns
+	// HasMoreDeliveryStreams and expects the last seen name to be passed back in as ExclusiveStartDeliveryStreamName.
+	var lastSeenName *string
+	for {
+		output, err := kfs.Client.ListDeliveryStreams(c, &firehose.ListDeliveryStreamsInput{
+			ExclusiveStartDeliveryStreamName: lastSeenName,
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, name := range output.DeliveryStreamNames {
+			describeOutput, err := kfs.Client.DescribeDeliveryStream(c, &firehose.DescribeDeliveryStreamInput{
+				DeliveryStreamName: aws.String(name),
+			})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			// Firehose delivery streams have no native DeletionProtection flag, so operators lean on tags (e.g.
+			// cloud-nuke:protect=true) to guard them instead, the same way Kinesis streams do. Fetch them here
+			// so ShouldInclude can apply the configured tag-based include/exclude rules.
+			tags, err := kfs.deliveryStreamTags(c, aws.String(name))
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			description := describeOutput.DeliveryStreamDescription
+			if configObj.KinesisFirehose.ShouldInclude(config.ResourceValue{
+				Name: aws.String(name),
+				Tags: tags,
+				Time: description.CreateTimestamp,
+			}) && configObj.KinesisFirehose.ShouldIncludeType(string(description.DeliveryStreamType)) {
+				allDeliveryStreams = append(allDeliveryStreams, aws.String(name))
+			}
+		}
+
+		if !output.HasMoreDeliveryStreams {
+			break
+		}
+		lastSeenName = aws.String(output.DeliveryStreamNames[len(output.DeliveryStreamNames)-1])
+	}
+
+	return allDeliveryStreams, nil
+}
+
+// deliveryStreamTags returns the tags attached to deliveryStreamName as a plain key/value map, paginating
+// through ListTagsForDeliveryStream until HasMoreTags is false.
+func (kfs *KinesisFirehoseStreams) deliveryStreamTags(c context.Context, deliveryStreamName *string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	var exclusiveStartTagKey *string
+	for {
+		output, err := kfs.Client.ListTagsForDeliveryStream(c, &firehose.ListTagsForDeliveryStreamInput{
+			DeliveryStreamName:   deliveryStreamName,
+			ExclusiveStartTagKey: exclusiveStartTagKey,
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, tag := range output.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+
+		if !output.HasMoreTags || len(output.Tags) == 0 {
+			break
+		}
+		exclusiveStartTagKey = output.Tags[len(output.Tags)-1].Key
+	}
+
+	return tags, nil
+}
+
+func (kfs *KinesisFirehoseStreams) nukeAll(identifiers []*string) error {
+	if len(identifiers) == 0 {
+		logging.Debugf("No Kinesis Firehose delivery streams to nuke in region: %s", kfs.Region)
+		return nil
+	}
+
+	// There is no bulk delete Firehose API, so we delete delivery streams through a bounded worker pool, which
+	// keeps us under AWS's per-second rate limit regardless of how many delivery streams are passed in.
+	logging.Debugf("Deleting Kinesis Firehose delivery streams in region: %s", kfs.Region)
+	runner := concurrency.NewBoundedRunner()
+	errs := runner.Run(kfs.Context, identifiers, kfs.deleteAsync)
+
+	// Collect all the errors from the worker pool into a single error struct.
+	var allErrs *multierror.Error
+	for _, err := range errs {
+		if err != nil {
+			allErrs = multierror.Append(allErrs, err)
+		}
+	}
+	finalErr := allErrs.ErrorOrNil()
+	if finalErr != nil {
+		return errors.WithStackTrace(finalErr)
+	}
+	return nil
+}
+
+func (kfs *KinesisFirehoseStreams) deleteAsync(ctx context.Context, streamName *string) error {
+	input := &firehose.DeleteDeliveryStreamInput{DeliveryStreamName: streamName}
+	_, err := kfs.Client.DeleteDeliveryStream(ctx, input)
+
+	// Record status of this resource
+	e := report.Entry{
+		Identifier:   aws.ToString(streamName),
+		ResourceType: "Kinesis Firehose Delivery Stream",
+		Error:        err,
+	}
+	report.Record(e)
+
+	streamNameStr := aws.ToString(streamName)
+	if err == nil {
+		logging.Debugf("[OK] Kinesis Firehose delivery stream %s delete in %s", streamNameStr, kfs.Region)
+	} else {
+		logging.Debugf("[Failed] Error deleting Kinesis Firehose delivery stream %s in %s: %s", streamNameStr, kfs.Region, err)
+	}
+
+	return err
+}
@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+)
+
+type mockKinesisFirehoseStreamsAPI struct {
+	KinesisFirehoseStreamsAPI
+	streamNames   []string
+	streamTypes   map[string]types.DeliveryStreamType
+	tagsByStream  map[string]map[string]string
+	deletedStream *string
+}
+
+func (m *mockKinesisFirehoseStreamsAPI) ListDeliveryStreams(_ context.Context, _ *firehose.ListDeliveryStreamsInput, _ ...func(*firehose.Options)) (*firehose.ListDeliveryStreamsOutput, error) {
+	return &firehose.ListDeliveryStreamsOutput{
+		DeliveryStreamNames:    m.streamNames,
+		HasMoreDeliveryStreams: false,
+	}, nil
+}
+
+func (m *mockKinesisFirehoseStreamsAPI) DescribeDeliveryStream(_ context.Context, params *firehose.DescribeDeliveryStreamInput, _ ...func(*firehose.Options)) (*firehose.DescribeDeliveryStreamOutput, error) {
+	name := aws.ToString(params.DeliveryStreamName)
+	return &firehose.DescribeDeliveryStreamOutput{
+		DeliveryStreamDescription: &types.DeliveryStreamDescription{
+			DeliveryStreamName: aws.String(name),
+			DeliveryStreamType: m.streamTypes[name],
+			CreateTimestamp:    aws.Time(time.Now()),
+		},
+	}, nil
+}
+
+func (m *mockKinesisFirehoseStreamsAPI) ListTagsForDeliveryStream(_ context.Context, params *firehose.ListTagsForDeliveryStreamInput, _ ...func(*firehose.Options)) (*firehose.ListTagsForDeliveryStreamOutput, error) {
+	var tags []types.Tag
+	for k, v := range m.tagsByStream[aws.ToString(params.DeliveryStreamName)] {
+		tags = append(tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return &firehose.ListTagsForDeliveryStreamOutput{Tags: tags, HasMoreTags: false}, nil
+}
+
+func (m *mockKinesisFirehoseStreamsAPI) DeleteDeliveryStream(_ context.Context, params *firehose.DeleteDeliveryStreamInput, _ ...func(*firehose.Options)) (*firehose.DeleteDeliveryStreamOutput, error) {
+	m.deletedStream = params.DeliveryStreamName
+	return &firehose.DeleteDeliveryStreamOutput{}, nil
+}
+
+func TestKinesisFirehoseStreamsGetAll_FiltersByDeliveryStreamType(t *testing.T) {
+	kfs := KinesisFirehoseStreams{
+		Client: &mockKinesisFirehoseStreamsAPI{
+			streamNames: []string{"direct-put-stream", "kinesis-sourced-stream"},
+			streamTypes: map[string]types.DeliveryStreamType{
+				"direct-put-stream":      types.DeliveryStreamTypeDirectPut,
+				"kinesis-sourced-stream": types.DeliveryStreamTypeKinesisStreamAsSource,
+			},
+		},
+	}
+
+	configObj := config.Config{
+		KinesisFirehose: config.KinesisFirehoseResourceType{
+			DeliveryStreamTypes: []string{string(types.DeliveryStreamTypeDirectPut)},
+		},
+	}
+
+	names, err := kfs.getAll(context.Background(), configObj)
+	if err != nil {
+		t.Fatalf("getAll returned an error: %v", err)
+	}
+
+	if len(names) != 1 || aws.ToString(names[0]) != "direct-put-stream" {
+		t.Fatalf("expected only direct-put-stream to be included, got %v", aws.ToStringSlice(names))
+	}
+}
+
+func TestKinesisFirehoseStreamsGetAll_TagFilter(t *testing.T) {
+	kfs := KinesisFirehoseStreams{
+		Client: &mockKinesisFirehoseStreamsAPI{
+			streamNames: []string{"protected-stream", "unprotected-stream"},
+			streamTypes: map[string]types.DeliveryStreamType{
+				"protected-stream":   types.DeliveryStreamTypeDirectPut,
+				"unprotected-stream": types.DeliveryStreamTypeDirectPut,
+			},
+			tagsByStream: map[string]map[string]string{
+				"protected-stream": {"cloud-nuke:protect": "true"},
+			},
+		},
+	}
+
+	configObj := config.Config{
+		KinesisFirehose: config.KinesisFirehoseResourceType{
+			ResourceType: config.ResourceType{
+				ExcludeTagFilter: config.TagFilter{Tags: []config.Tag{{Key: "cloud-nuke:protect", Value: "true"}}},
+			},
+		},
+	}
+
+	names, err := kfs.getAll(context.Background(), configObj)
+	if err != nil {
+		t.Fatalf("getAll returned an error: %v", err)
+	}
+
+	if len(names) != 1 || aws.ToString(names[0]) != "unprotected-stream" {
+		t.Fatalf("expected only unprotected-stream to be returned, got %v", aws.ToStringSlice(names))
+	}
+}
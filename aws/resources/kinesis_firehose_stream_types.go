@@ -0,0 +1,67 @@
+package resources
+
+import (
+	"context"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// KinesisFirehoseStreamsAPI represents the firehose API methods used by this package, split out so that the
+// tests can use a mocked version of the interface.
+type KinesisFirehoseStreamsAPI interface {
+	ListDeliveryStreams(ctx context.Context, params *firehose.ListDeliveryStreamsInput, optFns ...func(*firehose.Options)) (*firehose.ListDeliveryStreamsOutput, error)
+	DescribeDeliveryStream(ctx context.Context, params *firehose.DescribeDeliveryStreamInput, optFns ...func(*firehose.Options)) (*firehose.DescribeDeliveryStreamOutput, error)
+	ListTagsForDeliveryStream(ctx context.Context, params *firehose.ListTagsForDeliveryStreamInput, optFns ...func(*firehose.Options)) (*firehose.ListTagsForDeliveryStreamOutput, error)
+	DeleteDeliveryStream(ctx context.Context, params *firehose.DeleteDeliveryStreamInput, optFns ...func(*firehose.Options)) (*firehose.DeleteDeliveryStreamOutput, error)
+}
+
+// KinesisFirehoseStreams - represents all Kinesis Firehose delivery streams that should be deleted.
+type KinesisFirehoseStreams struct {
+	BaseAwsResource
+	Client          KinesisFirehoseStreamsAPI
+	Region          string
+	DeliveryStreams []string
+}
+
+func (kfs *KinesisFirehoseStreams) Init(cfg awsgo.Config) {
+	kfs.Client = firehose.NewFromConfig(cfg)
+}
+
+// ResourceName - the simple name of the aws resource
+func (kfs *KinesisFirehoseStreams) ResourceName() string {
+	return "kinesis-firehose"
+}
+
+// ResourceIdentifiers - The names of the Kinesis Firehose delivery streams
+func (kfs *KinesisFirehoseStreams) ResourceIdentifiers() []string {
+	return kfs.DeliveryStreams
+}
+
+// MaxBatchSize is intentionally large: nukeAll feeds identifiers through a concurrency.BoundedRunner worker
+// pool rather than fanning out one goroutine per identifier, so there's no need for the caller to chunk batches
+// down to a small, hardcoded size to avoid throttling AWS.
+func (kfs *KinesisFirehoseStreams) MaxBatchSize() int {
+	return 1000
+}
+
+func (kfs *KinesisFirehoseStreams) GetAndSetIdentifiers(c context.Context, configObj config.Config) ([]string, error) {
+	identifiers, err := kfs.getAll(c, configObj)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	kfs.DeliveryStreams = awsgo.ToStringSlice(identifiers)
+	return kfs.DeliveryStreams, nil
+}
+
+// Nuke - nuke 'em all!!!
+func (kfs *KinesisFirehoseStreams) Nuke(identifiers []string) error {
+	if err := kfs.nukeAll(awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
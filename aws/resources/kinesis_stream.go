@@ -2,17 +2,23 @@ package resources
 
 import (
 	"context"
-	"sync"
+	stderrors "errors"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/internal/concurrency"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/report"
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/hashicorp/go-multierror"
 )
 
+// drainPollInterval is how often we poll GetRecords while waiting for a stream to go quiet.
+const drainPollInterval = 5 * time.Second
+
 func (ks *KinesisStreams) getAll(c context.Context, configObj config.Config) ([]*string, error) {
 	var allStreams []*string
 
@@ -24,8 +30,17 @@ func (ks *KinesisStreams) getAll(c context.Context, configObj config.Config) ([]
 		}
 
 		for _, stream := range page.StreamNames {
+			// Kinesis streams have no native DeletionProtection flag, so operators lean on tags (e.g.
+			// Environment=prod or cloud-nuke:protect=true) to guard streams instead. Fetch them here so
+			// ShouldInclude can apply the configured tag-based include/exclude rules.
+			tags, err := ks.streamTags(c, aws.String(stream))
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
 			if configObj.KinesisStream.ShouldInclude(config.ResourceValue{
 				Name: aws.String(stream),
+				Tags: tags,
 			}) {
 				allStreams = append(allStreams, aws.String(stream))
 			}
@@ -35,38 +50,54 @@ func (ks *KinesisStreams) getAll(c context.Context, configObj config.Config) ([]
 	return allStreams, nil
 }
 
+// streamTags returns the tags attached to streamName as a plain key/value map, paginating through
+// ListTagsForStream until HasMoreTags is false.
+func (ks *KinesisStreams) streamTags(c context.Context, streamName *string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	var exclusiveStartTagKey *string
+	for {
+		output, err := ks.Client.ListTagsForStream(c, &kinesis.ListTagsForStreamInput{
+			StreamName:           streamName,
+			ExclusiveStartTagKey: exclusiveStartTagKey,
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, tag := range output.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+
+		if !output.HasMoreTags || len(output.Tags) == 0 {
+			break
+		}
+		exclusiveStartTagKey = output.Tags[len(output.Tags)-1].Key
+	}
+
+	return tags, nil
+}
+
 func (ks *KinesisStreams) nukeAll(identifiers []*string) error {
 	if len(identifiers) == 0 {
 		logging.Debugf("No Kinesis Streams to nuke in region: %s", ks.Region)
+		return nil
 	}
 
-	// NOTE: we don't need to do pagination here, because the pagination is handled by the caller to this function,
-	// based on KinesisStream.MaxBatchSize, however we add a guard here to warn users when the batching fails and
-	// has a chance of throttling AWS. Since we concurrently make one call for each identifier, we pick 100 for the
-	// limit here because many APIs in AWS have a limit of 100 requests per second.
-	if len(identifiers) > 100 {
-		logging.Errorf("Nuking too many Kinesis Streams at once (100): halting to avoid hitting AWS API rate limiting")
-		return TooManyStreamsErr{}
-	}
-
-	// There is no bulk delete Kinesis Stream API, so we delete the batch of Kinesis Streams concurrently
-	// using go routines.
+	// There is no bulk delete Kinesis Stream API, so we delete streams through a bounded worker pool instead of
+	// one goroutine per identifier. This removes the old hard cap on batch size (previously enforced via
+	// TooManyStreamsErr) and keeps us under AWS's per-second rate limit regardless of how many streams are
+	// passed in.
 	logging.Debugf("Deleting Kinesis Streams in region: %s", ks.Region)
-	wg := new(sync.WaitGroup)
-	wg.Add(len(identifiers))
-	errChans := make([]chan error, len(identifiers))
-	for i, streamName := range identifiers {
-		errChans[i] = make(chan error, 1)
-		go ks.deleteAsync(wg, errChans[i], streamName)
-	}
-	wg.Wait()
+	runner := concurrency.NewBoundedRunner()
+	errs := runner.Run(ks.Context, identifiers, ks.deleteAsync)
 
-	// Collect all the errors from the async delete calls into a single error struct.
+	// Collect all the errors from the worker pool into a single error struct.
 	// NOTE: We ignore OperationAbortedException which is thrown when there is an eventual consistency issue, where
 	// cloud-nuke picks up a Stream that is already requested to be deleted.
 	var allErrs *multierror.Error
-	for _, errChan := range errChans {
-		if err := <-errChan; err != nil {
+	for _, err := range errs {
+		if err != nil {
 			allErrs = multierror.Append(allErrs, err)
 		}
 	}
@@ -77,37 +108,200 @@ func (ks *KinesisStreams) nukeAll(identifiers []*string) error {
 	return nil
 }
 
-func (ks *KinesisStreams) deleteAsync(
-	wg *sync.WaitGroup,
-	errChan chan error,
-	streamName *string,
-) {
-	defer wg.Done()
+func (ks *KinesisStreams) deleteAsync(ctx context.Context, streamName *string) error {
+	streamNameStr := aws.ToString(streamName)
+
+	if ks.Config.KinesisStream.DrainBeforeDelete {
+		drained, err := ks.waitForDrain(ctx, streamName, ks.Config.KinesisStream.GetDrainTimeout())
+		if err != nil {
+			e := report.Entry{
+				Identifier:   streamNameStr,
+				ResourceType: "Kinesis Stream",
+				Error:        err,
+			}
+			report.Record(e)
+			return err
+		}
+		if !drained {
+			err := StreamStillReceivingRecordsErr{StreamName: streamNameStr}
+			e := report.Entry{
+				Identifier:   streamNameStr,
+				ResourceType: "Kinesis Stream",
+				Error:        err,
+			}
+			report.Record(e)
+			logging.Debugf("[Failed] Kinesis Stream %s in %s is still receiving records, skipping delete", streamNameStr, ks.Region)
+			return err
+		}
+	}
+
+	// Deregister any enhanced fan-out consumers before deleting the stream. DeleteStream succeeds even when
+	// consumers are still registered, which leaves them as orphaned, billable resources if the stream's ARN is
+	// ever reused.
+	if err := ks.deregisterConsumers(ctx, streamName); err != nil {
+		return err
+	}
+
 	input := &kinesis.DeleteStreamInput{StreamName: streamName}
-	_, err := ks.Client.DeleteStream(ks.Context, input)
+	_, err := ks.Client.DeleteStream(ctx, input)
 
 	// Record status of this resource
 	e := report.Entry{
-		Identifier:   aws.ToString(streamName),
+		Identifier:   streamNameStr,
 		ResourceType: "Kinesis Stream",
 		Error:        err,
 	}
 	report.Record(e)
 
-	errChan <- err
-
-	streamNameStr := aws.ToString(streamName)
 	if err == nil {
 		logging.Debugf("[OK] Kinesis Stream %s delete in %s", streamNameStr, ks.Region)
 	} else {
 		logging.Debugf("[Failed] Error deleting Kinesis Stream %s in %s: %s", streamNameStr, ks.Region, err)
 	}
+
+	return err
 }
 
-// Custom errors
+// waitForDrain polls every shard of streamName for incoming records using a LATEST shard iterator, and returns
+// true only if no records showed up on any shard for the entirety of timeout. This gives operators a safety net
+// against nuking a stream that is still actively receiving data, at the cost of adding up to timeout of latency
+// per stream before it is deleted.
+func (ks *KinesisStreams) waitForDrain(ctx context.Context, streamName *string, timeout time.Duration) (bool, error) {
+	shardIterators, err := ks.latestShardIterators(ctx, streamName)
+	if err != nil {
+		return false, errors.WithStackTrace(err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		quiet, err := ks.pollShardIterators(ctx, streamName, shardIterators)
+		if err != nil {
+			return false, err
+		}
+		if !quiet {
+			return false, nil
+		}
+
+		// Check the deadline after polling rather than before sleeping, so a zero or already-elapsed
+		// timeout returns as soon as the one required poll comes back empty instead of sleeping a full
+		// drainPollInterval first.
+		if !time.Now().Before(deadline) {
+			return true, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, errors.WithStackTrace(ctx.Err())
+		case <-time.After(drainPollInterval):
+		}
+	}
+}
 
-type TooManyStreamsErr struct{}
+// pollShardIterators makes one GetRecords call against every shard in shardIterators, advancing each shard's
+// iterator to the one GetRecords returns. It reports quiet as false as soon as any shard has records, without
+// waiting to check the rest.
+func (ks *KinesisStreams) pollShardIterators(ctx context.Context, streamName *string, shardIterators map[string]*string) (quiet bool, err error) {
+	for shardID, iterator := range shardIterators {
+		output, err := ks.Client.GetRecords(ctx, &kinesis.GetRecordsInput{ShardIterator: iterator})
+		if err != nil {
+			return false, errors.WithStackTrace(err)
+		}
+
+		if len(output.Records) > 0 {
+			logging.Debugf("Kinesis Stream %s shard %s received %d record(s) during drain check in %s", aws.ToString(streamName), shardID, len(output.Records), ks.Region)
+			return false, nil
+		}
+
+		shardIterators[shardID] = output.NextShardIterator
+	}
+
+	return true, nil
+}
+
+func (ks *KinesisStreams) latestShardIterators(ctx context.Context, streamName *string) (map[string]*string, error) {
+	shardIterators := make(map[string]*string)
+
+	paginator := kinesis.NewListShardsPaginator(ks.Client, &kinesis.ListShardsInput{StreamName: streamName})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, shard := range page.Shards {
+			iteratorOutput, err := ks.Client.GetShardIterator(ctx, &kinesis.GetShardIteratorInput{
+				StreamName:        streamName,
+				ShardId:           shard.ShardId,
+				ShardIteratorType: types.ShardIteratorTypeLatest,
+			})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			shardIterators[aws.ToString(shard.ShardId)] = iteratorOutput.ShardIterator
+		}
+	}
+
+	return shardIterators, nil
+}
+
+// deregisterConsumers looks up the stream's ARN and deregisters every enhanced fan-out consumer registered
+// against it, reporting each one as its own report.Entry so operators can see exactly what was cleaned up.
+func (ks *KinesisStreams) deregisterConsumers(ctx context.Context, streamName *string) error {
+	describeOutput, err := ks.Client.DescribeStreamSummary(ctx, &kinesis.DescribeStreamSummaryInput{StreamName: streamName})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	streamARN := describeOutput.StreamDescriptionSummary.StreamARN
+
+	paginator := kinesis.NewListStreamConsumersPaginator(ks.Client, &kinesis.ListStreamConsumersInput{StreamARN: streamARN})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+
+		for _, consumer := range page.Consumers {
+			_, err := ks.Client.DeregisterStreamConsumer(ctx, &kinesis.DeregisterStreamConsumerInput{
+				ConsumerARN: consumer.ConsumerARN,
+			})
+			if isConsumerAlreadyDeregistered(err) {
+				err = nil
+			}
+
+			report.Record(report.Entry{
+				Identifier:   aws.ToString(consumer.ConsumerName),
+				ResourceType: "Kinesis Stream Consumer",
+				Error:        err,
+			})
+
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isConsumerAlreadyDeregistered returns true if err is the ResourceNotFoundException Kinesis returns when
+// DeregisterStreamConsumer is called against a consumer that is already gone. deleteAsync can be retried as a
+// whole by the bounded worker pool's backoff, so a later attempt may re-deregister consumers an earlier attempt
+// already removed; treating this as success rather than a failure keeps that retry idempotent.
+func isConsumerAlreadyDeregistered(err error) bool {
+	if err == nil {
+		return false
+	}
+	var notFound *types.ResourceNotFoundException
+	return stderrors.As(err, &notFound)
+}
+
+// StreamStillReceivingRecordsErr is returned when KinesisStream.DrainBeforeDelete is enabled and a stream is
+// still receiving records after the configured drain timeout, so cloud-nuke refuses to delete it.
+type StreamStillReceivingRecordsErr struct {
+	StreamName string
+}
 
-func (err TooManyStreamsErr) Error() string {
-	return "Too many Streams requested at once."
+func (err StreamStillReceivingRecordsErr) Error() string {
+	return "Kinesis Stream " + err.StreamName + " is still receiving records; refusing to delete while DrainBeforeDelete is enabled"
 }
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/internal/concurrency"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/report"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+func (kc *KinesisStreamConsumers) getAll(c context.Context, configObj config.Config) ([]*string, error) {
+	var allConsumerARNs []*string
+
+	streamPaginator := kinesis.NewListStreamsPaginator(kc.Client, &kinesis.ListStreamsInput{})
+	for streamPaginator.HasMorePages() {
+		streamPage, err := streamPaginator.NextPage(c)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, streamName := range streamPage.StreamNames {
+			describeOutput, err := kc.Client.DescribeStreamSummary(c, &kinesis.DescribeStreamSummaryInput{
+				StreamName: aws.String(streamName),
+			})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			streamARN := describeOutput.StreamDescriptionSummary.StreamARN
+
+			consumerPaginator := kinesis.NewListStreamConsumersPaginator(kc.Client, &kinesis.ListStreamConsumersInput{StreamARN: streamARN})
+			for consumerPaginator.HasMorePages() {
+				consumerPage, err := consumerPaginator.NextPage(c)
+				if err != nil {
+					return nil, errors.WithStackTrace(err)
+				}
+
+				for _, consumer := range consumerPage.Consumers {
+					if configObj.KinesisStreamConsumer.ShouldInclude(config.ResourceValue{
+						Name: consumer.ConsumerName,
+						Time: consumer.ConsumerCreationTimestamp,
+					}) {
+						allConsumerARNs = append(allConsumerARNs, consumer.ConsumerARN)
+					}
+				}
+			}
+		}
+	}
+
+	return allConsumerARNs, nil
+}
+
+func (kc *KinesisStreamConsumers) nukeAll(identifiers []*string) error {
+	if len(identifiers) == 0 {
+		logging.Debugf("No Kinesis Stream Consumers to nuke in region: %s", kc.Region)
+		return nil
+	}
+
+	logging.Debugf("Deregistering Kinesis Stream Consumers in region: %s", kc.Region)
+	runner := concurrency.NewBoundedRunner()
+	errs := runner.Run(kc.Context, identifiers, kc.deleteAsync)
+
+	var allErrs *multierror.Error
+	for _, err := range errs {
+		if err != nil {
+			allErrs = multierror.Append(allErrs, err)
+		}
+	}
+	finalErr := allErrs.ErrorOrNil()
+	if finalErr != nil {
+		return errors.WithStackTrace(finalErr)
+	}
+	return nil
+}
+
+func (kc *KinesisStreamConsumers) deleteAsync(ctx context.Context, consumerARN *string) error {
+	_, err := kc.Client.DeregisterStreamConsumer(ctx, &kinesis.DeregisterStreamConsumerInput{
+		ConsumerARN: consumerARN,
+	})
+
+	report.Record(report.Entry{
+		Identifier:   aws.ToString(consumerARN),
+		ResourceType: "Kinesis Stream Consumer",
+		Error:        err,
+	})
+
+	consumerARNStr := aws.ToString(consumerARN)
+	if err == nil {
+		logging.Debugf("[OK] Kinesis Stream Consumer %s delete in %s", consumerARNStr, kc.Region)
+	} else {
+		logging.Debugf("[Failed] Error deregistering Kinesis Stream Consumer %s in %s: %s", consumerARNStr, kc.Region, err)
+	}
+
+	return err
+}
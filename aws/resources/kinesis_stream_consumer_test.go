@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+)
+
+type mockKinesisStreamConsumersAPI struct {
+	KinesisStreamConsumersAPI
+	streamNames []string
+	consumers   map[string][]types.Consumer
+}
+
+func (m *mockKinesisStreamConsumersAPI) ListStreams(_ context.Context, _ *kinesis.ListStreamsInput, _ ...func(*kinesis.Options)) (*kinesis.ListStreamsOutput, error) {
+	return &kinesis.ListStreamsOutput{StreamNames: m.streamNames, HasMoreStreams: aws.Bool(false)}, nil
+}
+
+func (m *mockKinesisStreamConsumersAPI) DescribeStreamSummary(_ context.Context, params *kinesis.DescribeStreamSummaryInput, _ ...func(*kinesis.Options)) (*kinesis.DescribeStreamSummaryOutput, error) {
+	return &kinesis.DescribeStreamSummaryOutput{
+		StreamDescriptionSummary: &types.StreamDescriptionSummary{
+			StreamARN: aws.String("arn:aws:kinesis:us-east-1:000000000000:stream/" + aws.ToString(params.StreamName)),
+		},
+	}, nil
+}
+
+func (m *mockKinesisStreamConsumersAPI) ListStreamConsumers(_ context.Context, params *kinesis.ListStreamConsumersInput, _ ...func(*kinesis.Options)) (*kinesis.ListStreamConsumersOutput, error) {
+	return &kinesis.ListStreamConsumersOutput{Consumers: m.consumers[aws.ToString(params.StreamARN)]}, nil
+}
+
+func TestKinesisStreamConsumersGetAll_ReturnsConsumerARNs(t *testing.T) {
+	streamARN := "arn:aws:kinesis:us-east-1:000000000000:stream/my-stream"
+	kc := KinesisStreamConsumers{
+		Client: &mockKinesisStreamConsumersAPI{
+			streamNames: []string{"my-stream"},
+			consumers: map[string][]types.Consumer{
+				streamARN: {
+					{
+						ConsumerName:              aws.String("my-consumer"),
+						ConsumerARN:               aws.String(streamARN + "/consumer/my-consumer:1"),
+						ConsumerCreationTimestamp: aws.Time(time.Now()),
+					},
+				},
+			},
+		},
+	}
+
+	names, err := kc.getAll(context.Background(), config.Config{})
+	if err != nil {
+		t.Fatalf("getAll returned an error: %v", err)
+	}
+
+	if len(names) != 1 || aws.ToString(names[0]) != streamARN+"/consumer/my-consumer:1" {
+		t.Fatalf("expected the consumer ARN to be returned, got %v", aws.ToStringSlice(names))
+	}
+}
@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// KinesisStreamConsumersAPI represents the kinesis API methods used by this package, split out so that the
+// tests can use a mocked version of the interface.
+type KinesisStreamConsumersAPI interface {
+	ListStreams(ctx context.Context, params *kinesis.ListStreamsInput, optFns ...func(*kinesis.Options)) (*kinesis.ListStreamsOutput, error)
+	DescribeStreamSummary(ctx context.Context, params *kinesis.DescribeStreamSummaryInput, optFns ...func(*kinesis.Options)) (*kinesis.DescribeStreamSummaryOutput, error)
+	ListStreamConsumers(ctx context.Context, params *kinesis.ListStreamConsumersInput, optFns ...func(*kinesis.Options)) (*kinesis.ListStreamConsumersOutput, error)
+	DeregisterStreamConsumer(ctx context.Context, params *kinesis.DeregisterStreamConsumerInput, optFns ...func(*kinesis.Options)) (*kinesis.DeregisterStreamConsumerOutput, error)
+}
+
+// KinesisStreamConsumers - represents all Kinesis enhanced fan-out stream consumers that should be deleted. This
+// lets operators nuke orphaned consumers independently of the streams they were registered against, which is
+// useful since KinesisStreams.nukeAll already cleans up consumers for streams it deletes. Consumers can be
+// filtered by creation time only (see config.NameAndTimeResourceType); there is no rule that matches a
+// consumer's name or ARN.
+type KinesisStreamConsumers struct {
+	BaseAwsResource
+	Client    KinesisStreamConsumersAPI
+	Region    string
+	Consumers []string
+}
+
+func (kc *KinesisStreamConsumers) Init(cfg awsgo.Config) {
+	kc.Client = kinesis.NewFromConfig(cfg)
+}
+
+// ResourceName - the simple name of the aws resource
+func (kc *KinesisStreamConsumers) ResourceName() string {
+	return "kinesis-stream-consumer"
+}
+
+// ResourceIdentifiers - The ARNs of the Kinesis stream consumers
+func (kc *KinesisStreamConsumers) ResourceIdentifiers() []string {
+	return kc.Consumers
+}
+
+func (kc *KinesisStreamConsumers) MaxBatchSize() int {
+	return 1000
+}
+
+func (kc *KinesisStreamConsumers) GetAndSetIdentifiers(c context.Context, configObj config.Config) ([]string, error) {
+	identifiers, err := kc.getAll(c, configObj)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	kc.Consumers = awsgo.ToStringSlice(identifiers)
+	return kc.Consumers, nil
+}
+
+// Nuke - nuke 'em all!!!
+func (kc *KinesisStreamConsumers) Nuke(identifiers []string) error {
+	if err := kc.nukeAll(awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
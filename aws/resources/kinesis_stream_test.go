@@ -0,0 +1,174 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// mockKinesisStreamsAPI lets each test stub only the calls it cares about; unconfigured methods return a zero
+// value so callers that don't exercise a given code path don't need to wire it up.
+type mockKinesisStreamsAPI struct {
+	KinesisStreamsAPI
+	streamNames          []string
+	tagsByStream         map[string]map[string]string
+	shardIDs             []string
+	getRecordsFn         func(shardIterator *string) (*kinesis.GetRecordsOutput, error)
+	deregisterConsumerFn func(consumerARN *string) error
+	deleteStreamCalled   bool
+}
+
+func (m *mockKinesisStreamsAPI) ListStreams(_ context.Context, _ *kinesis.ListStreamsInput, _ ...func(*kinesis.Options)) (*kinesis.ListStreamsOutput, error) {
+	return &kinesis.ListStreamsOutput{StreamNames: m.streamNames, HasMoreStreams: aws.Bool(false)}, nil
+}
+
+func (m *mockKinesisStreamsAPI) ListTagsForStream(_ context.Context, params *kinesis.ListTagsForStreamInput, _ ...func(*kinesis.Options)) (*kinesis.ListTagsForStreamOutput, error) {
+	var tags []types.Tag
+	for k, v := range m.tagsByStream[aws.ToString(params.StreamName)] {
+		tags = append(tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return &kinesis.ListTagsForStreamOutput{Tags: tags, HasMoreTags: false}, nil
+}
+
+func (m *mockKinesisStreamsAPI) DescribeStreamSummary(_ context.Context, params *kinesis.DescribeStreamSummaryInput, _ ...func(*kinesis.Options)) (*kinesis.DescribeStreamSummaryOutput, error) {
+	return &kinesis.DescribeStreamSummaryOutput{
+		StreamDescriptionSummary: &types.StreamDescriptionSummary{
+			StreamARN: aws.String("arn:aws:kinesis:us-east-1:000000000000:stream/" + aws.ToString(params.StreamName)),
+		},
+	}, nil
+}
+
+func (m *mockKinesisStreamsAPI) ListStreamConsumers(_ context.Context, _ *kinesis.ListStreamConsumersInput, _ ...func(*kinesis.Options)) (*kinesis.ListStreamConsumersOutput, error) {
+	return &kinesis.ListStreamConsumersOutput{}, nil
+}
+
+func (m *mockKinesisStreamsAPI) DeregisterStreamConsumer(_ context.Context, params *kinesis.DeregisterStreamConsumerInput, _ ...func(*kinesis.Options)) (*kinesis.DeregisterStreamConsumerOutput, error) {
+	if m.deregisterConsumerFn != nil {
+		return &kinesis.DeregisterStreamConsumerOutput{}, m.deregisterConsumerFn(params.ConsumerARN)
+	}
+	return &kinesis.DeregisterStreamConsumerOutput{}, nil
+}
+
+func (m *mockKinesisStreamsAPI) ListShards(_ context.Context, _ *kinesis.ListShardsInput, _ ...func(*kinesis.Options)) (*kinesis.ListShardsOutput, error) {
+	var shards []types.Shard
+	for _, id := range m.shardIDs {
+		shards = append(shards, types.Shard{ShardId: aws.String(id)})
+	}
+	return &kinesis.ListShardsOutput{Shards: shards}, nil
+}
+
+func (m *mockKinesisStreamsAPI) GetShardIterator(_ context.Context, params *kinesis.GetShardIteratorInput, _ ...func(*kinesis.Options)) (*kinesis.GetShardIteratorOutput, error) {
+	return &kinesis.GetShardIteratorOutput{ShardIterator: aws.String("iterator-" + aws.ToString(params.ShardId))}, nil
+}
+
+func (m *mockKinesisStreamsAPI) GetRecords(_ context.Context, params *kinesis.GetRecordsInput, _ ...func(*kinesis.Options)) (*kinesis.GetRecordsOutput, error) {
+	if m.getRecordsFn != nil {
+		return m.getRecordsFn(params.ShardIterator)
+	}
+	return &kinesis.GetRecordsOutput{NextShardIterator: params.ShardIterator}, nil
+}
+
+func (m *mockKinesisStreamsAPI) DeleteStream(_ context.Context, _ *kinesis.DeleteStreamInput, _ ...func(*kinesis.Options)) (*kinesis.DeleteStreamOutput, error) {
+	m.deleteStreamCalled = true
+	return &kinesis.DeleteStreamOutput{}, nil
+}
+
+func TestKinesisStreamsGetAll_TagFilter(t *testing.T) {
+	ks := KinesisStreams{
+		Client: &mockKinesisStreamsAPI{
+			streamNames: []string{"protected-stream", "unprotected-stream"},
+			tagsByStream: map[string]map[string]string{
+				"protected-stream": {"cloud-nuke:protect": "true"},
+			},
+		},
+	}
+
+	configObj := config.Config{
+		KinesisStream: config.KinesisStreamResourceType{
+			ResourceType: config.ResourceType{
+				ExcludeTagFilter: config.TagFilter{Tags: []config.Tag{{Key: "cloud-nuke:protect", Value: "true"}}},
+			},
+		},
+	}
+
+	names, err := ks.getAll(context.Background(), configObj)
+	if err != nil {
+		t.Fatalf("getAll returned an error: %v", err)
+	}
+
+	if len(names) != 1 || aws.ToString(names[0]) != "unprotected-stream" {
+		t.Fatalf("expected only unprotected-stream to be returned, got %v", aws.ToStringSlice(names))
+	}
+}
+
+func TestWaitForDrain_DrainedAfterEmptyPollReturnsTrue(t *testing.T) {
+	ks := &KinesisStreams{
+		Client: &mockKinesisStreamsAPI{
+			shardIDs: []string{"shard-1", "shard-2"},
+			getRecordsFn: func(shardIterator *string) (*kinesis.GetRecordsOutput, error) {
+				return &kinesis.GetRecordsOutput{NextShardIterator: shardIterator}, nil
+			},
+		},
+	}
+
+	// A zero timeout means the deadline has already passed by the time the first poll comes back, so
+	// waitForDrain should return true after exactly one empty poll round instead of sleeping.
+	drained, err := ks.waitForDrain(context.Background(), aws.String("my-stream"), 0)
+	if err != nil {
+		t.Fatalf("waitForDrain returned an error: %v", err)
+	}
+	if !drained {
+		t.Error("expected waitForDrain to report the stream as drained after an empty poll")
+	}
+}
+
+func TestDeleteAsync_StillReceivingRecordsSkipsDelete(t *testing.T) {
+	mockClient := &mockKinesisStreamsAPI{
+		shardIDs: []string{"shard-1"},
+		getRecordsFn: func(shardIterator *string) (*kinesis.GetRecordsOutput, error) {
+			return &kinesis.GetRecordsOutput{
+				Records:           []types.Record{{}},
+				NextShardIterator: shardIterator,
+			}, nil
+		},
+	}
+
+	ks := &KinesisStreams{Client: mockClient}
+	ks.Context = context.Background()
+	ks.Config = config.Config{
+		KinesisStream: config.KinesisStreamResourceType{DrainBeforeDelete: true, DrainTimeout: time.Minute},
+	}
+
+	err := ks.deleteAsync(context.Background(), aws.String("my-stream"))
+
+	if _, ok := err.(StreamStillReceivingRecordsErr); !ok {
+		t.Fatalf("expected a StreamStillReceivingRecordsErr, got %v", err)
+	}
+	if mockClient.deleteStreamCalled {
+		t.Error("expected DeleteStream not to be called while the stream is still receiving records")
+	}
+}
+
+func TestIsConsumerAlreadyDeregistered(t *testing.T) {
+	if isConsumerAlreadyDeregistered(nil) {
+		t.Error("expected a nil error not to be treated as already-deregistered")
+	}
+
+	notFound := &types.ResourceNotFoundException{Message: aws.String("consumer not found")}
+	if !isConsumerAlreadyDeregistered(notFound) {
+		t.Error("expected a ResourceNotFoundException to be treated as already-deregistered")
+	}
+	if !isConsumerAlreadyDeregistered(errors.WithStackTrace(notFound)) {
+		t.Error("expected a wrapped ResourceNotFoundException to still be detected")
+	}
+
+	if isConsumerAlreadyDeregistered(&types.LimitExceededException{Message: aws.String("throttled")}) {
+		t.Error("expected a throttling error not to be treated as already-deregistered")
+	}
+}
@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"context"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// KinesisStreamsAPI represents the kinesis API methods used by this package, split out so that the tests can
+// use a mocked version of the interface.
+type KinesisStreamsAPI interface {
+	ListStreams(ctx context.Context, params *kinesis.ListStreamsInput, optFns ...func(*kinesis.Options)) (*kinesis.ListStreamsOutput, error)
+	ListTagsForStream(ctx context.Context, params *kinesis.ListTagsForStreamInput, optFns ...func(*kinesis.Options)) (*kinesis.ListTagsForStreamOutput, error)
+	DescribeStreamSummary(ctx context.Context, params *kinesis.DescribeStreamSummaryInput, optFns ...func(*kinesis.Options)) (*kinesis.DescribeStreamSummaryOutput, error)
+	ListStreamConsumers(ctx context.Context, params *kinesis.ListStreamConsumersInput, optFns ...func(*kinesis.Options)) (*kinesis.ListStreamConsumersOutput, error)
+	DeregisterStreamConsumer(ctx context.Context, params *kinesis.DeregisterStreamConsumerInput, optFns ...func(*kinesis.Options)) (*kinesis.DeregisterStreamConsumerOutput, error)
+	ListShards(ctx context.Context, params *kinesis.ListShardsInput, optFns ...func(*kinesis.Options)) (*kinesis.ListShardsOutput, error)
+	GetShardIterator(ctx context.Context, params *kinesis.GetShardIteratorInput, optFns ...func(*kinesis.Options)) (*kinesis.GetShardIteratorOutput, error)
+	GetRecords(ctx context.Context, params *kinesis.GetRecordsInput, optFns ...func(*kinesis.Options)) (*kinesis.GetRecordsOutput, error)
+	DeleteStream(ctx context.Context, params *kinesis.DeleteStreamInput, optFns ...func(*kinesis.Options)) (*kinesis.DeleteStreamOutput, error)
+}
+
+// KinesisStreams - represents all Kinesis Data Streams that should be deleted.
+type KinesisStreams struct {
+	BaseAwsResource
+	Client  KinesisStreamsAPI
+	Region  string
+	Streams []string
+}
+
+func (ks *KinesisStreams) Init(cfg awsgo.Config) {
+	ks.Client = kinesis.NewFromConfig(cfg)
+}
+
+// ResourceName - the simple name of the aws resource
+func (ks *KinesisStreams) ResourceName() string {
+	return "kinesis-stream"
+}
+
+// ResourceIdentifiers - The names of the Kinesis Streams
+func (ks *KinesisStreams) ResourceIdentifiers() []string {
+	return ks.Streams
+}
+
+// MaxBatchSize is intentionally large: nukeAll feeds identifiers through a concurrency.BoundedRunner worker
+// pool rather than fanning out one goroutine per identifier, so there's no need for the caller to chunk batches
+// down to a small, hardcoded size to avoid throttling AWS.
+func (ks *KinesisStreams) MaxBatchSize() int {
+	return 1000
+}
+
+func (ks *KinesisStreams) GetAndSetIdentifiers(c context.Context, configObj config.Config) ([]string, error) {
+	identifiers, err := ks.getAll(c, configObj)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	ks.Streams = awsgo.ToStringSlice(identifiers)
+	return ks.Streams, nil
+}
+
+// Nuke - nuke 'em all!!!
+func (ks *KinesisStreams) Nuke(identifiers []string) error {
+	if err := ks.nukeAll(awsgo.StringSlice(identifiers)); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"context"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+)
+
+// AwsResource is the interface implemented by every nukeable AWS resource type in this package. The CLI looks
+// resources up by name through resourceRegistrations below rather than hardcoding resource names anywhere else,
+// so a new resource type is reachable from the CLI only once it has an entry in that map.
+type AwsResource interface {
+	Init(cfg awsgo.Config)
+	ResourceName() string
+	ResourceIdentifiers() []string
+	MaxBatchSize() int
+	GetAndSetIdentifiers(c context.Context, configObj config.Config) ([]string, error)
+	Nuke(identifiers []string) error
+}
+
+// resourceRegistrations maps a resource's ResourceName() to a constructor for it. This is the single place a
+// resource type must be added in order for --resource-type and the config file's per-resource-type rules to be
+// able to reach it.
+var resourceRegistrations = map[string]func() AwsResource{
+	"kinesis-stream":          func() AwsResource { return &KinesisStreams{} },
+	"kinesis-firehose":        func() AwsResource { return &KinesisFirehoseStreams{} },
+	"kinesis-stream-consumer": func() AwsResource { return &KinesisStreamConsumers{} },
+}
@@ -0,0 +1,111 @@
+package config
+
+import "time"
+
+// Config is the root of the nuke configuration file. Each field maps a resource type to the include/exclude
+// rules that decide which of that type's resources are candidates for deletion.
+type Config struct {
+	KinesisStream         KinesisStreamResourceType
+	KinesisFirehose       KinesisFirehoseResourceType
+	KinesisStreamConsumer NameAndTimeResourceType
+}
+
+// ResourceValue carries the attributes of a single candidate resource that ShouldInclude needs in order to
+// evaluate a ResourceType's rules against it. Not every resource type populates every field - Kinesis streams
+// supply Tags, for instance, while Kinesis stream consumers do not.
+type ResourceValue struct {
+	Name *string
+	Tags map[string]string
+	Time *time.Time
+}
+
+// ResourceType holds the include/exclude rules shared by every resource type: a tag-based filter (see
+// config.TagFilter) and an optional "created before" cutoff used to skip resources created too recently to
+// safely nuke.
+type ResourceType struct {
+	TagFilter        TagFilter  `yaml:"tag_filter"`
+	ExcludeTagFilter TagFilter  `yaml:"exclude_tag_filter"`
+	CreatedBefore    *time.Time `yaml:"-"`
+}
+
+// ShouldInclude returns true if value should be targeted for nuking under this ResourceType's rules. A match on
+// ExcludeTagFilter always wins; otherwise value must match TagFilter (when any tag rules are configured) and
+// must have been created before CreatedBefore (when set).
+func (r ResourceType) ShouldInclude(value ResourceValue) bool {
+	if r.ExcludeTagFilter.Matches(value.Tags) {
+		return false
+	}
+
+	if len(r.TagFilter.Tags) > 0 && !r.TagFilter.Matches(value.Tags) {
+		return false
+	}
+
+	if r.CreatedBefore != nil && value.Time != nil && value.Time.After(*r.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// NameAndTimeResourceType is for resource types that don't support tag-based filtering at all - the AWS API
+// behind them has no way to read tags back, so offering tag_filter/exclude_tag_filter in the config file would
+// parse fine but silently never match anything. Kinesis stream consumers are the first such resource: enhanced
+// fan-out consumers aren't independently taggable. The only rule it applies is CreatedBefore; ResourceValue.Name
+// is not matched against anything, so resources cannot be filtered by name.
+type NameAndTimeResourceType struct {
+	CreatedBefore *time.Time `yaml:"-"`
+}
+
+// ShouldInclude returns true if value should be targeted for nuking, based only on CreatedBefore (when set).
+func (r NameAndTimeResourceType) ShouldInclude(value ResourceValue) bool {
+	if r.CreatedBefore != nil && value.Time != nil && value.Time.After(*r.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// DefaultKinesisStreamDrainTimeout is the drain timeout applied when DrainBeforeDelete is enabled but
+// DrainTimeout is left at its zero value in the config file.
+const DefaultKinesisStreamDrainTimeout = 30 * time.Second
+
+// KinesisStreamResourceType extends ResourceType with the opt-in drain-before-delete behavior: when
+// DrainBeforeDelete is enabled, nukeAll waits for a stream to stop receiving records before deleting it instead
+// of deleting immediately.
+type KinesisStreamResourceType struct {
+	ResourceType      `yaml:",inline"`
+	DrainBeforeDelete bool          `yaml:"drain_before_delete"`
+	DrainTimeout      time.Duration `yaml:"drain_timeout"`
+}
+
+// GetDrainTimeout returns DrainTimeout, falling back to DefaultKinesisStreamDrainTimeout when it is unset.
+func (r KinesisStreamResourceType) GetDrainTimeout() time.Duration {
+	if r.DrainTimeout <= 0 {
+		return DefaultKinesisStreamDrainTimeout
+	}
+	return r.DrainTimeout
+}
+
+// KinesisFirehoseResourceType extends ResourceType with a filter on the delivery stream's type (e.g. DirectPut
+// vs KinesisStreamAsSource), since a Firehose delivery stream that is itself fed by a Kinesis stream is often
+// managed independently of the stream it reads from.
+type KinesisFirehoseResourceType struct {
+	ResourceType        `yaml:",inline"`
+	DeliveryStreamTypes []string `yaml:"delivery_stream_types"`
+}
+
+// ShouldIncludeType returns true if deliveryStreamType should be targeted. An empty DeliveryStreamTypes list
+// matches every delivery stream type.
+func (r KinesisFirehoseResourceType) ShouldIncludeType(deliveryStreamType string) bool {
+	if len(r.DeliveryStreamTypes) == 0 {
+		return true
+	}
+
+	for _, t := range r.DeliveryStreamTypes {
+		if t == deliveryStreamType {
+			return true
+		}
+	}
+
+	return false
+}
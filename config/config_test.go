@@ -0,0 +1,22 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKinesisStreamResourceType_GetDrainTimeout(t *testing.T) {
+	t.Run("falls back to the default when unset", func(t *testing.T) {
+		r := KinesisStreamResourceType{}
+		if r.GetDrainTimeout() != DefaultKinesisStreamDrainTimeout {
+			t.Errorf("expected default timeout %v, got %v", DefaultKinesisStreamDrainTimeout, r.GetDrainTimeout())
+		}
+	})
+
+	t.Run("honors a configured timeout", func(t *testing.T) {
+		r := KinesisStreamResourceType{DrainTimeout: 5 * time.Minute}
+		if r.GetDrainTimeout() != 5*time.Minute {
+			t.Errorf("expected configured timeout 5m, got %v", r.GetDrainTimeout())
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Tag is a single tag key/value rule used by a ResourceType's tag-based include/exclude filters. Both Key and
+// Value support glob-style wildcards ("*"), full regular expressions (wrapped in "~...~"), or a plain literal
+// match.
+type Tag struct {
+	Key   string `yaml:"key"`
+	Value string `yaml:"value"`
+}
+
+// TagFilter groups a set of Tag rules that, taken together, gate whether a resource should be included in or
+// excluded from a nuke run based on its tags. This is the shared helper referenced by resources (like Kinesis
+// streams) that have no native deletion-protection flag and instead rely on tags such as
+// "cloud-nuke:protect=true" to signal that a resource should be left alone.
+type TagFilter struct {
+	Tags []Tag `yaml:"tags"`
+}
+
+// Matches returns true if any rule in f matches one of the given tags. Callers should treat an empty filter
+// (no rules configured) as "this filter does not apply", not as "match everything".
+func (f TagFilter) Matches(tags map[string]string) bool {
+	for _, rule := range f.Tags {
+		for key, value := range tags {
+			if matchTagPattern(rule.Key, key) && matchTagPattern(rule.Value, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchTagPattern matches a tag key or value against a pattern that is either empty/"*" (match anything), a
+// regular expression wrapped in "~...~", a glob containing "*", or a plain literal.
+func matchTagPattern(pattern, actual string) bool {
+	if pattern == "" || pattern == "*" {
+		return true
+	}
+
+	if strings.HasPrefix(pattern, "~") && strings.HasSuffix(pattern, "~") && len(pattern) > 1 {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return false
+		}
+		return re.MatchString(actual)
+	}
+
+	if strings.Contains(pattern, "*") {
+		globRe, err := regexp.Compile("^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$")
+		if err != nil {
+			return false
+		}
+		return globRe.MatchString(actual)
+	}
+
+	return pattern == actual
+}
@@ -0,0 +1,40 @@
+package config
+
+import "testing"
+
+func TestResourceType_ShouldInclude_TagFilter(t *testing.T) {
+	r := ResourceType{
+		TagFilter: TagFilter{Tags: []Tag{{Key: "Environment", Value: "prod*"}}},
+	}
+
+	if !r.ShouldInclude(ResourceValue{Tags: map[string]string{"Environment": "production"}}) {
+		t.Error("expected a resource tagged Environment=production to be included")
+	}
+	if r.ShouldInclude(ResourceValue{Tags: map[string]string{"Environment": "staging"}}) {
+		t.Error("expected a resource tagged Environment=staging to be excluded")
+	}
+	if r.ShouldInclude(ResourceValue{Tags: nil}) {
+		t.Error("expected an untagged resource to be excluded once a TagFilter rule is configured")
+	}
+}
+
+func TestResourceType_ShouldInclude_ExcludeTagFilterWinsOverTagFilter(t *testing.T) {
+	r := ResourceType{
+		TagFilter:        TagFilter{Tags: []Tag{{Key: "*", Value: "*"}}},
+		ExcludeTagFilter: TagFilter{Tags: []Tag{{Key: "cloud-nuke:protect", Value: "true"}}},
+	}
+
+	if r.ShouldInclude(ResourceValue{Tags: map[string]string{"cloud-nuke:protect": "true"}}) {
+		t.Error("expected a protected resource to be excluded even though TagFilter matches everything")
+	}
+	if !r.ShouldInclude(ResourceValue{Tags: map[string]string{"Name": "whatever"}}) {
+		t.Error("expected an unprotected resource to still be included")
+	}
+}
+
+func TestResourceType_ShouldInclude_NoTagRulesMatchesEverything(t *testing.T) {
+	r := ResourceType{}
+	if !r.ShouldInclude(ResourceValue{Tags: map[string]string{"anything": "goes"}}) {
+		t.Error("expected a ResourceType with no tag rules configured to include every resource")
+	}
+}
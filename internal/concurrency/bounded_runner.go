@@ -0,0 +1,153 @@
+// Package concurrency provides a reusable worker pool for resources that need to delete many identifiers
+// concurrently without overwhelming an AWS API's request-rate limits.
+package concurrency
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gruntwork-io/go-commons/errors"
+	"golang.org/x/time/rate"
+)
+
+// DefaultWorkers is the default number of goroutines a BoundedRunner uses to drain its work queue.
+const DefaultWorkers = 100
+
+// DefaultRateLimit is the default number of operations per second a BoundedRunner allows across all of its
+// workers.
+const DefaultRateLimit = 100
+
+// RetryableFunc is called once per identifier by a worker. Returning a retryable error (see IsThrottlingError)
+// causes the BoundedRunner to retry the item with exponential backoff rather than failing it outright.
+type RetryableFunc func(ctx context.Context, identifier *string) error
+
+// BoundedRunner runs a RetryableFunc over an arbitrarily large list of identifiers using a fixed-size worker
+// pool, rather than the one-goroutine-per-identifier pattern that forces callers to cap batch sizes to avoid
+// throttling AWS. It also applies a token-bucket rate limit across all workers and retries throttling errors
+// with exponential backoff, following the same pattern the Benthos Kinesis writer uses for its shard writes.
+type BoundedRunner struct {
+	// Workers is the number of goroutines used to drain the work queue. Defaults to DefaultWorkers.
+	Workers int
+
+	// Limiter caps the aggregate rate of calls into Fn across all workers. Defaults to DefaultRateLimit
+	// requests/sec.
+	Limiter *rate.Limiter
+
+	// MaxRetries is the number of times a single identifier is retried after a retryable error before it is
+	// reported as failed. Defaults to 5.
+	MaxRetries int
+}
+
+// NewBoundedRunner returns a BoundedRunner configured with the package defaults.
+func NewBoundedRunner() *BoundedRunner {
+	return &BoundedRunner{
+		Workers:    DefaultWorkers,
+		Limiter:    rate.NewLimiter(rate.Limit(DefaultRateLimit), DefaultRateLimit),
+		MaxRetries: 5,
+	}
+}
+
+// workItem pairs an identifier with its position in the original identifiers slice, so that workers can report
+// results back by index rather than keying on the identifier's pointer identity - which breaks if the same
+// *string appears more than once in identifiers.
+type workItem struct {
+	index      int
+	identifier *string
+}
+
+// Run feeds identifiers through a fixed-size worker pool, calling fn for each one, and returns one error per
+// identifier: errs[i] is the result for identifiers[i], nil on success. It blocks until every identifier has been
+// processed.
+func (r *BoundedRunner) Run(ctx context.Context, identifiers []*string, fn RetryableFunc) []error {
+	workers := r.Workers
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	limiter := r.Limiter
+	if limiter == nil {
+		limiter = rate.NewLimiter(rate.Limit(DefaultRateLimit), DefaultRateLimit)
+	}
+
+	work := make(chan workItem, len(identifiers))
+	for i, identifier := range identifiers {
+		work <- workItem{index: i, identifier: identifier}
+	}
+	close(work)
+
+	errs := make([]error, len(identifiers))
+	var mu sync.Mutex
+
+	wg := new(sync.WaitGroup)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for item := range work {
+				if err := limiter.Wait(ctx); err != nil {
+					mu.Lock()
+					errs[item.index] = errors.WithStackTrace(err)
+					mu.Unlock()
+					continue
+				}
+
+				err := r.runWithBackoff(ctx, item.identifier, fn)
+				mu.Lock()
+				errs[item.index] = err
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// runWithBackoff retries fn with exponential backoff while it keeps returning a throttling error, up to
+// MaxRetries retries after the first attempt (MaxRetries+1 calls in all).
+func (r *BoundedRunner) runWithBackoff(ctx context.Context, identifier *string, fn RetryableFunc) error {
+	maxRetries := r.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = 5
+	}
+
+	var err error
+	backoff := 100 * time.Millisecond
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		err = fn(ctx, identifier)
+		if err == nil || !IsThrottlingError(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	return errors.WithStackTrace(err)
+}
+
+// IsThrottlingError returns true if err is a throttling error that should be retried, such as Kinesis's
+// LimitExceededException or ProvisionedThroughputExceededException.
+func IsThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := err.Error()
+	for _, throttlingErr := range []string{
+		"LimitExceededException",
+		"ProvisionedThroughputExceededException",
+		"Rate exceeded",
+		"Throttling",
+	} {
+		if strings.Contains(msg, throttlingErr) {
+			return true
+		}
+	}
+	return false
+}
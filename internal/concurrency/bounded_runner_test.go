@@ -0,0 +1,42 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/time/rate"
+)
+
+// TestRun_DuplicatePointerIdentifiers guards against keying in-flight work by identifier pointer identity: if
+// the same *string appears twice in identifiers, both occurrences must get their own result slot instead of one
+// silently overwriting the other.
+func TestRun_DuplicatePointerIdentifiers(t *testing.T) {
+	shared := "duplicate-identifier"
+	identifiers := []*string{&shared, &shared}
+
+	calls := 0
+	runner := &BoundedRunner{
+		Workers:    1,
+		Limiter:    rate.NewLimiter(rate.Inf, 1),
+		MaxRetries: 0,
+	}
+
+	errs := runner.Run(context.Background(), identifiers, func(_ context.Context, _ *string) error {
+		calls++
+		if calls == 1 {
+			return errors.New("first occurrence failed")
+		}
+		return nil
+	})
+
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(errs))
+	}
+	if errs[0] == nil {
+		t.Errorf("expected the first occurrence's error to be preserved, got nil")
+	}
+	if errs[1] != nil {
+		t.Errorf("expected the second occurrence to succeed, got %v", errs[1])
+	}
+}